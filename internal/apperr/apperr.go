@@ -0,0 +1,68 @@
+// Package apperr maps internal errors (repository sentinel errors, in the
+// first place) to stable, client-facing error codes so handlers no longer
+// need to hand-roll an errors.Is switch per endpoint.
+package apperr
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/himmel520/uoffer/require/internal/repository"
+)
+
+// APIError is the machine-readable shape of a failed request, safe to
+// expose to clients: Message is a stable, non-leaking description and
+// Details carries optional extra context (e.g. validation errors).
+type APIError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Details string `json:"details,omitempty"`
+}
+
+func (e APIError) Error() string {
+	return e.Message
+}
+
+const (
+	CodeInternal                   = "INTERNAL_ERROR"
+	CodeBadRequest                 = "BAD_REQUEST"
+	CodeCategoryNotFound           = "CATEGORY_NOT_FOUND"
+	CodeCategoryExists             = "CATEGORY_EXISTS"
+	CodePostIDExists               = "POST_ID_EXISTS"
+	CodeAnalyticDependencyConflict = "ANALYTIC_DEPENDENCY_CONFLICT"
+	CodeAnalyticNotFound           = "ANALYTIC_NOT_FOUND"
+	CodePostNotFound               = "POST_NOT_FOUND"
+)
+
+var table = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{repository.ErrCategoryNotFound, http.StatusNotFound, CodeCategoryNotFound},
+	{repository.ErrCategoryExists, http.StatusConflict, CodeCategoryExists},
+	{repository.ErrPostIDExist, http.StatusBadRequest, CodePostIDExists},
+	{repository.ErrAnalyticDependencyNotFound, http.StatusConflict, CodeAnalyticDependencyConflict},
+	{repository.ErrAnalyticNotFound, http.StatusNotFound, CodeAnalyticNotFound},
+	{repository.ErrPostNotFound, http.StatusNotFound, CodePostNotFound},
+}
+
+// Map translates err into the HTTP status and APIError to send back to the
+// client. Unrecognized errors are collapsed into a generic 500 so internal
+// error strings never leak to callers.
+func Map(err error) (int, APIError) {
+	for _, e := range table {
+		if errors.Is(err, e.err) {
+			return e.status, APIError{Code: e.code, Message: e.err.Error()}
+		}
+	}
+
+	return http.StatusInternalServerError, APIError{Code: CodeInternal, Message: "internal server error"}
+}
+
+// BadRequest builds the APIError for a request that failed binding or
+// validation, keeping err's message since it is caller-supplied input, not
+// an internal detail.
+func BadRequest(err error) APIError {
+	return APIError{Code: CodeBadRequest, Message: err.Error()}
+}
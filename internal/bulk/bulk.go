@@ -0,0 +1,24 @@
+// Package bulk holds the request/result shapes shared by every bulk
+// create/update/delete endpoint, so the service and repository layers
+// don't need to depend on the http package to report per-item outcomes.
+package bulk
+
+// MaxBatchSize caps the number of items accepted by a single bulk request.
+const MaxBatchSize = 500
+
+// Result is the outcome of processing a single item of a bulk request.
+// Err is nil on success.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// Ok builds a successful Result.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{Value: value}
+}
+
+// Failed builds a failed Result.
+func Failed[T any](err error) Result[T] {
+	return Result[T]{Err: err}
+}
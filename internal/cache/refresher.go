@@ -0,0 +1,120 @@
+// Package cache provides a cron-driven precomputation layer for read-heavy
+// projections: it periodically calls a Fetcher, caches the JSON-encoded
+// result behind an ETag, and serves that snapshot until the next run
+// replaces it.
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// DefaultSchedule is used when no schedule is configured.
+const DefaultSchedule = "@every 60s"
+
+type logger interface {
+	Error(string)
+}
+
+// Fetcher computes the value a Refresher caches.
+type Fetcher[T any] func(ctx context.Context) (T, error)
+
+// Refresher runs Fetcher on a cron schedule and serves the last successful
+// result from memory. A repository error during a run is logged and the
+// previous snapshot keeps being served.
+type Refresher[T any] struct {
+	fetch Fetcher[T]
+	log   logger
+	cron  *cron.Cron
+
+	mu           sync.RWMutex
+	body         []byte
+	etag         string
+	lastModified time.Time
+
+	// isRunning/lastCompletedTime guard against overlapping runs: a
+	// schedule tick is skipped outright if the previous run hasn't
+	// finished yet, rather than queuing.
+	isRunning         sync.Map
+	lastCompletedTime sync.Map
+}
+
+func NewRefresher[T any](fetch Fetcher[T], log logger) *Refresher[T] {
+	return &Refresher[T]{fetch: fetch, log: log}
+}
+
+// Start runs an immediate refresh to warm the cache, then schedules
+// further refreshes per schedule (a robfig/cron spec, e.g. "@every 60s")
+// until ctx is canceled.
+func (r *Refresher[T]) Start(ctx context.Context, schedule string) error {
+	if schedule == "" {
+		schedule = DefaultSchedule
+	}
+
+	r.cron = cron.New()
+	if _, err := r.cron.AddFunc(schedule, func() { r.Refresh(ctx) }); err != nil {
+		return err
+	}
+
+	r.Refresh(ctx)
+	r.cron.Start()
+
+	go func() {
+		<-ctx.Done()
+		<-r.cron.Stop().Done()
+	}()
+
+	return nil
+}
+
+// Refresh runs the fetcher once, unless a previous run is still in
+// flight, and swaps in the new snapshot on success. It is safe to call
+// concurrently, including from the /admin/cache/refresh handler while the
+// cron schedule is also running.
+func (r *Refresher[T]) Refresh(ctx context.Context) {
+	if _, alreadyRunning := r.isRunning.LoadOrStore("running", true); alreadyRunning {
+		return
+	}
+	defer r.isRunning.Delete("running")
+
+	value, err := r.fetch(ctx)
+	if err != nil {
+		r.log.Error("cache refresh: fetch failed: " + err.Error())
+		return
+	}
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		r.log.Error("cache refresh: marshal failed: " + err.Error())
+		return
+	}
+
+	sum := sha256.Sum256(body)
+
+	r.mu.Lock()
+	r.body = body
+	r.etag = `"` + hex.EncodeToString(sum[:]) + `"`
+	r.lastModified = time.Now().UTC()
+	r.mu.Unlock()
+
+	r.lastCompletedTime.Store("lastCompletedTime", r.lastModified)
+}
+
+// Snapshot returns the last cached body, its ETag and Last-Modified time.
+// ok is false when no run has completed yet.
+func (r *Refresher[T]) Snapshot() (body []byte, etag string, lastModified time.Time, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.body == nil {
+		return nil, "", time.Time{}, false
+	}
+
+	return r.body, r.etag, r.lastModified, true
+}
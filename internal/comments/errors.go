@@ -0,0 +1,11 @@
+package comments
+
+import "errors"
+
+var (
+	ErrInvalidTargetType = errors.New("invalid commentable type")
+	ErrTargetNotFound    = errors.New("commentable target not found")
+	ErrCommentNotFound   = errors.New("comment not found")
+	ErrParentNotFound    = errors.New("parent comment not found")
+	ErrNestedReply       = errors.New("comments only support one level of threading")
+)
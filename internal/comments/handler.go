@@ -0,0 +1,187 @@
+package comments
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/himmel520/uoffer/require/internal/apperr"
+	"github.com/himmel520/uoffer/require/internal/respond"
+)
+
+// Handler exposes the comments subsystem over HTTP. It is registered
+// alongside the existing http.Handler's routes, not embedded in it, since
+// comments are attached to several unrelated resources (posts, analytics).
+// 5xx logging is handled by the shared middleware.Logging installed on the
+// router, so Handler doesn't need its own logger.
+type Handler struct {
+	srv *Service
+}
+
+func NewHandler(srv *Service) *Handler {
+	return &Handler{srv: srv}
+}
+
+// RegisterRoutes wires the comments endpoints onto public and admin, mirroring the
+// grouping the rest of the API uses (a public router group plus an
+// /admin one for moderation).
+func (h *Handler) RegisterRoutes(public, admin *gin.RouterGroup) {
+	public.POST("/posts/:id/comments", h.create(TargetPost))
+	public.GET("/posts/:id/comments", h.list(TargetPost))
+	public.POST("/analytic/:id/comments", h.create(TargetAnalytic))
+	public.GET("/analytic/:id/comments", h.list(TargetAnalytic))
+
+	admin.DELETE("/comments/:id", h.delete)
+}
+
+type createCommentBody struct {
+	ParentID *int64 `json:"parent_id"`
+	Body     string `json:"body" binding:"required"`
+}
+
+// create returns a handler bound to targetType, so the same code path
+// serves both /posts/{id}/comments and /analytic/{id}/comments.
+//
+// @Summary Добавить комментарий
+// @Description Добавляет комментарий к посту или аналитике
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path int true "ID ресурса"
+// @Param comment body createCommentBody true "Текст комментария"
+// @Success 201 {object} respond.APIResponse[Comment]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 401 {object} respond.APIResponse[any] "Unauthorized"
+// @Failure 404 {object} respond.APIResponse[any] "Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /posts/{id}/comments [post]
+func (h *Handler) create(targetType TargetType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			respond.BadRequest(c, err)
+			return
+		}
+
+		rawUserID, ok := c.Keys["user_id"]
+		if !ok {
+			respond.Fail(c, http.StatusUnauthorized, apperr.APIError{Code: "USER_ID_NOT_FOUND", Message: "not found user_id"})
+			return
+		}
+
+		userID, ok := rawUserID.(int64)
+		if !ok {
+			respond.Fail(c, http.StatusUnauthorized, apperr.APIError{Code: "USER_ID_NOT_FOUND", Message: "invalid user_id"})
+			return
+		}
+
+		var body createCommentBody
+		if err := c.BindJSON(&body); err != nil {
+			respond.BadRequest(c, err)
+			return
+		}
+
+		comment := &Comment{
+			CommentableType: targetType,
+			CommentableID:   targetID,
+			ParentID:        body.ParentID,
+			UserID:          userID,
+			Body:            body.Body,
+		}
+
+		created, err := h.srv.Create(c.Request.Context(), comment)
+		if err != nil {
+			h.fail(c, err)
+			return
+		}
+
+		respond.OK(c, http.StatusCreated, created)
+	}
+}
+
+// @Summary Получить комментарии
+// @Description Возвращает комментарии к посту или аналитике с курсорной пагинацией
+// @Tags Comments
+// @Produce json
+// @Param id path int true "ID ресурса"
+// @Param after query int false "ID последнего просмотренного комментария"
+// @Param limit query int false "Размер страницы" default(20)
+// @Success 200 {object} respond.APIResponse[Page]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 404 {object} respond.APIResponse[any] "Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /posts/{id}/comments [get]
+func (h *Handler) list(targetType TargetType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			respond.BadRequest(c, err)
+			return
+		}
+
+		after, err := strconv.ParseInt(c.DefaultQuery("after", "0"), 10, 64)
+		if err != nil {
+			respond.BadRequest(c, err)
+			return
+		}
+
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(DefaultListLimit)))
+		if err != nil {
+			respond.BadRequest(c, err)
+			return
+		}
+
+		page, err := h.srv.ListByTarget(c.Request.Context(), targetType, targetID, Cursor{After: after, Limit: limit})
+		if err != nil {
+			h.fail(c, err)
+			return
+		}
+
+		respond.OK(c, http.StatusOK, page)
+	}
+}
+
+// @Summary Удалить комментарий
+// @Description Удаляет комментарий по ID
+// @Tags Comments
+// @Param id path int true "ID комментария"
+// @Success 204 "No Content"
+// @Failure 404 {object} respond.APIResponse[any] "Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /admin/comments/{id} [delete]
+func (h *Handler) delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	if err := h.srv.Delete(c.Request.Context(), id); err != nil {
+		h.fail(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// fail maps the comments-package sentinel errors that apperr doesn't know
+// about, since they live outside the repository package apperr is built
+// around.
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidTargetType):
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{Code: "INVALID_COMMENTABLE_TYPE", Message: err.Error()})
+	case errors.Is(err, ErrTargetNotFound):
+		respond.Fail(c, http.StatusNotFound, apperr.APIError{Code: "COMMENTABLE_TARGET_NOT_FOUND", Message: err.Error()})
+	case errors.Is(err, ErrParentNotFound):
+		respond.Fail(c, http.StatusNotFound, apperr.APIError{Code: "PARENT_COMMENT_NOT_FOUND", Message: err.Error()})
+	case errors.Is(err, ErrNestedReply):
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{Code: "NESTED_REPLY_NOT_ALLOWED", Message: err.Error()})
+	case errors.Is(err, ErrCommentNotFound):
+		respond.Fail(c, http.StatusNotFound, apperr.APIError{Code: "COMMENT_NOT_FOUND", Message: err.Error()})
+	default:
+		c.Error(err)
+		respond.Fail(c, http.StatusInternalServerError, apperr.APIError{Code: apperr.CodeInternal, Message: "internal server error"})
+	}
+}
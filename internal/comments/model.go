@@ -0,0 +1,40 @@
+// Package comments implements the polymorphic comments subsystem: a
+// comment references its parent resource by {commentable_type,
+// commentable_id} instead of a dedicated foreign key per resource, so the
+// same table backs comments on posts, analytics, or any future
+// commentable resource.
+package comments
+
+import "time"
+
+// TargetType identifies the kind of resource a comment is attached to.
+type TargetType string
+
+const (
+	TargetPost     TargetType = "post"
+	TargetAnalytic TargetType = "analytic"
+)
+
+// Valid reports whether t is one of the known commentable types.
+func (t TargetType) Valid() bool {
+	switch t {
+	case TargetPost, TargetAnalytic:
+		return true
+	default:
+		return false
+	}
+}
+
+// Comment is a single comment, optionally a reply to another comment via
+// ParentID. Only one level of threading is supported: a reply's ParentID
+// must point at a top-level comment.
+type Comment struct {
+	ID              int64      `json:"id"`
+	CommentableType TargetType `json:"commentable_type"`
+	CommentableID   int64      `json:"commentable_id"`
+	ParentID        *int64     `json:"parent_id,omitempty"`
+	UserID          int64      `json:"user_id"`
+	Body            string     `json:"body"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
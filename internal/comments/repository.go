@@ -0,0 +1,38 @@
+package comments
+
+import "context"
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// Cursor is the request side of keyset pagination over a comment thread:
+// After is the id of the last comment the client has already seen (0 for
+// the first page).
+type Cursor struct {
+	After int64
+	Limit int
+}
+
+// Page is the response side: NextCursor is the After value to pass for the
+// next page, valid only when HasMore is true.
+type Page struct {
+	Items      []Comment `json:"items"`
+	NextCursor int64     `json:"next_cursor"`
+	HasMore    bool      `json:"has_more"`
+}
+
+// Repository persists comments. Create does not check that a comment's
+// target exists: the Service does that via TargetExists before it calls
+// Create.
+type Repository interface {
+	Create(ctx context.Context, comment *Comment) (*Comment, error)
+	GetByID(ctx context.Context, id int64) (*Comment, error)
+	ListByTarget(ctx context.Context, targetType TargetType, targetID int64, cursor Cursor) (Page, error)
+	Delete(ctx context.Context, id int64) error
+	// TargetExists reports whether the resource identified by
+	// (targetType, targetID) exists, so Create can 404 before writing a
+	// comment that would reference nothing.
+	TargetExists(ctx context.Context, targetType TargetType, targetID int64) (bool, error)
+}
@@ -0,0 +1,72 @@
+package comments
+
+import (
+	"context"
+	"errors"
+)
+
+// Service implements the comments use cases on top of a Repository,
+// keeping the "does the target exist" and "is this valid threading"
+// checks out of the HTTP layer.
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Create validates the target and, for replies, the parent before
+// persisting the comment.
+func (s *Service) Create(ctx context.Context, comment *Comment) (*Comment, error) {
+	if !comment.CommentableType.Valid() {
+		return nil, ErrInvalidTargetType
+	}
+
+	exists, err := s.repo.TargetExists(ctx, comment.CommentableType, comment.CommentableID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrTargetNotFound
+	}
+
+	if comment.ParentID != nil {
+		parent, err := s.repo.GetByID(ctx, *comment.ParentID)
+		if err != nil {
+			if errors.Is(err, ErrCommentNotFound) {
+				return nil, ErrParentNotFound
+			}
+			return nil, err
+		}
+		if parent.CommentableType != comment.CommentableType || parent.CommentableID != comment.CommentableID {
+			return nil, ErrParentNotFound
+		}
+		if parent.ParentID != nil {
+			return nil, ErrNestedReply
+		}
+	}
+
+	return s.repo.Create(ctx, comment)
+}
+
+// ListByTarget returns one cursor-paginated page of comments for the given
+// target, defaulting and clamping cursor.Limit.
+func (s *Service) ListByTarget(ctx context.Context, targetType TargetType, targetID int64, cursor Cursor) (Page, error) {
+	if !targetType.Valid() {
+		return Page{}, ErrInvalidTargetType
+	}
+
+	if cursor.Limit <= 0 {
+		cursor.Limit = DefaultListLimit
+	}
+	if cursor.Limit > MaxListLimit {
+		cursor.Limit = MaxListLimit
+	}
+
+	return s.repo.ListByTarget(ctx, targetType, targetID, cursor)
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
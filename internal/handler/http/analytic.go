@@ -1,48 +1,48 @@
 package http
 
 import (
-	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/himmel520/uoffer/require/internal/repository"
+	"github.com/himmel520/uoffer/require/internal/apperr"
+	"github.com/himmel520/uoffer/require/internal/pagination"
+	"github.com/himmel520/uoffer/require/internal/respond"
 	"github.com/himmel520/uoffer/require/models"
 )
 
+// analyticWordsSortColumns is the allow-list of columns clients may sort
+// analytic words by, keyed by the `sort` query value.
+var analyticWordsSortColumns = map[string]string{
+	"word":  "word",
+	"count": "count",
+}
+
 // @Summary Добавить аналитику
 // @Description Добавляет новую аналитику
 // @Tags analytic
 // @Accept json
 // @Produce json
 // @Param analytic body models.Analytic true "Данные аналитики"
-// @Success 200 {object} models.Analytic
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 409 {object} errorResponse "Conflict"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Success 200 {object} respond.APIResponse[models.Analytic]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 409 {object} respond.APIResponse[any] "Conflict"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/analytic [post]
 func (h *Handler) addAnalytic(c *gin.Context) {
 	var analytic *models.Analytic
 	if err := c.BindJSON(&analytic); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
 		return
 	}
 
 	newAnalytic, err := h.srv.AddAnalytic(c.Request.Context(), analytic)
-	switch {
-	case errors.Is(err, repository.ErrPostIDExist):
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
-		return
-	case errors.Is(err, repository.ErrAnalyticDependencyNotFound):
-		c.AbortWithStatusJSON(http.StatusConflict, errorResponse{err.Error()})
-		return
-	case err != nil:
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+	if err != nil {
+		respond.FromError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, newAnalytic)
+	respond.OK(c, http.StatusOK, newAnalytic)
 }
 
 // @Summary Обновить аналитику
@@ -52,44 +52,33 @@ func (h *Handler) addAnalytic(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID аналитики"
 // @Param analytic body models.AnalyticUpdate true "Обновленные данные аналитики"
-// @Success 200 {object} models.Analytic
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 404 {object} errorResponse "Not Found"
-// @Failure 409 {object} errorResponse "Conflict"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Success 200 {object} respond.APIResponse[models.Analytic]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 404 {object} respond.APIResponse[any] "Not Found"
+// @Failure 409 {object} respond.APIResponse[any] "Conflict"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/analytic/{id} [put]
 func (h *Handler) updateAnalytic(c *gin.Context) {
 	id, _ := strconv.Atoi(c.Param("id"))
 
 	var analytic *models.AnalyticUpdate
 	if err := c.BindJSON(&analytic); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
 		return
 	}
 
 	if analytic.IsEmpty() {
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{"analytic has no changes"})
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{Code: apperr.CodeBadRequest, Message: "analytic has no changes"})
 		return
 	}
 
 	newAnalytic, err := h.srv.UpdateAnalytic(c.Request.Context(), id, analytic)
-	switch {
-	case errors.Is(err, repository.ErrPostIDExist):
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
-		return
-	case errors.Is(err, repository.ErrAnalyticNotFound):
-		c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
-		return
-	case errors.Is(err, repository.ErrAnalyticDependencyNotFound):
-		c.AbortWithStatusJSON(http.StatusConflict, errorResponse{err.Error()})
-		return
-	case err != nil:
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+	if err != nil {
+		respond.FromError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, newAnalytic)
+	respond.OK(c, http.StatusOK, newAnalytic)
 }
 
 // @Summary Удалить аналитику
@@ -97,51 +86,61 @@ func (h *Handler) updateAnalytic(c *gin.Context) {
 // @Tags analytic
 // @Param id path int true "ID аналитики"
 // @Success 204 "No Content"
-// @Failure 404 {object} errorResponse "Not Found"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Failure 404 {object} respond.APIResponse[any] "Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/analytic/{id} [delete]
 func (h *Handler) deleteAnalytic(c *gin.Context) {
 	id, _ := strconv.Atoi(c.Param("id"))
 
 	if err := h.srv.DeleteAnalytic(c.Request.Context(), id); err != nil {
-		if errors.Is(err, repository.ErrAnalyticNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
-			return
-		}
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+		respond.FromError(c, err)
 		return
 	}
+
 	c.Status(http.StatusNoContent)
 }
 
 // @Summary Получить аналитику с словами по ID поста
-// @Description Возвращает аналитику с словами по ID поста
+// @Description Возвращает постраничную аналитику с словами по ID поста
 // @Tags Analytic
 // @Param id path int true "ID поста"
-// @Success 200 {object} []models.AnalyticWithWords
-// @Failure 401 {object} errorResponse "Unauthorized"
-// @Failure 404 {object} errorResponse "Not Found"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Param page query int false "Номер страницы" default(1)
+// @Param per_page query int false "Размер страницы" default(20)
+// @Param sort query string false "Поле сортировки (word, count)"
+// @Param order query string false "Направление сортировки (asc, desc)"
+// @Success 200 {object} respond.APIResponse[pagination.Page[models.AnalyticWithWords]]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 401 {object} respond.APIResponse[any] "Unauthorized"
+// @Failure 404 {object} respond.APIResponse[any] "Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /analytic/post/{id} [get]
 func (h *Handler) getAnalyticWithWordsByPostID(c *gin.Context) {
 	id, _ := strconv.Atoi(c.Param("id"))
 	role, ok := c.Keys["role"]
 	if !ok {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse{"not found role"})
+		respond.Fail(c, http.StatusUnauthorized, apperr.APIError{Code: "ROLE_NOT_FOUND", Message: "not found role"})
+		return
+	}
+
+	params, err := pagination.Bind(c)
+	if err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	orderBy, err := pagination.NewOrderBy(params, analyticWordsSortColumns, "word")
+	if err != nil {
+		respond.BadRequest(c, err)
 		return
 	}
+	params.Sort = orderBy.Column
+	params.Order = orderBy.Direction
 
-	analytics, err := h.srv.GetAnalyticWithWords(c.Request.Context(), id, role.(string))
+	analytics, err := h.srv.GetAnalyticWithWords(c.Request.Context(), id, role.(string), params)
 	if err != nil {
-		if errors.Is(err, repository.ErrAnalyticNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
-			return
-		}
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+		respond.FromError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, analytics)
+	respond.OK(c, http.StatusOK, analytics)
 }
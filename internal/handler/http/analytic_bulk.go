@@ -0,0 +1,127 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/himmel520/uoffer/require/internal/apperr"
+	"github.com/himmel520/uoffer/require/internal/bulk"
+	"github.com/himmel520/uoffer/require/internal/respond"
+	"github.com/himmel520/uoffer/require/models"
+)
+
+func analyticID(analytic *models.Analytic) string {
+	return strconv.Itoa(analytic.ID)
+}
+
+// @Summary Массово добавить аналитику
+// @Description Добавляет несколько записей аналитики одной транзакцией
+// @Tags analytic
+// @Accept json
+// @Produce json
+// @Param best_effort query bool false "Не прерывать пакет при ошибке в отдельной строке"
+// @Param analytics body []models.Analytic true "Список новых записей аналитики"
+// @Success 200 {object} respond.APIResponse[bulkReport]
+// @Failure 207 {object} respond.APIResponse[bulkReport] "Multi-Status"
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /admin/analytic/bulk [post]
+func (h *Handler) addAnalyticsBulk(c *gin.Context) {
+	var analytics []*models.Analytic
+	if err := c.BindJSON(&analytics); err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	if len(analytics) == 0 || len(analytics) > bulk.MaxBatchSize {
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{
+			Code:    apperr.CodeBadRequest,
+			Message: "batch size must be between 1 and 500",
+		})
+		return
+	}
+
+	results, err := h.srv.AddAnalyticsBulk(c.Request.Context(), analytics, bulkBestEffort(c))
+	if err != nil {
+		respond.FromError(c, err)
+		return
+	}
+
+	report := bulkReportFrom(results, analyticID)
+	respondBulkReport(c, report, http.StatusOK)
+}
+
+// @Summary Массово обновить аналитику
+// @Description Обновляет несколько записей аналитики одной транзакцией
+// @Tags analytic
+// @Accept json
+// @Produce json
+// @Param best_effort query bool false "Не прерывать пакет при ошибке в отдельной строке"
+// @Param analytics body []models.Analytic true "Список обновляемых записей аналитики"
+// @Success 200 {object} respond.APIResponse[bulkReport]
+// @Failure 207 {object} respond.APIResponse[bulkReport] "Multi-Status"
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /admin/analytic/bulk [put]
+func (h *Handler) updateAnalyticsBulk(c *gin.Context) {
+	var analytics []*models.Analytic
+	if err := c.BindJSON(&analytics); err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	if len(analytics) == 0 || len(analytics) > bulk.MaxBatchSize {
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{
+			Code:    apperr.CodeBadRequest,
+			Message: "batch size must be between 1 and 500",
+		})
+		return
+	}
+
+	results, err := h.srv.UpdateAnalyticsBulk(c.Request.Context(), analytics, bulkBestEffort(c))
+	if err != nil {
+		respond.FromError(c, err)
+		return
+	}
+
+	report := bulkReportFrom(results, analyticID)
+	respondBulkReport(c, report, http.StatusOK)
+}
+
+// @Summary Массово удалить аналитику
+// @Description Удаляет несколько записей аналитики одной транзакцией
+// @Tags analytic
+// @Accept json
+// @Produce json
+// @Param best_effort query bool false "Не прерывать пакет при ошибке в отдельной строке"
+// @Param ids body []int true "Список ID аналитики"
+// @Success 200 {object} respond.APIResponse[bulkReport]
+// @Failure 207 {object} respond.APIResponse[bulkReport] "Multi-Status"
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /admin/analytic/bulk [delete]
+func (h *Handler) deleteAnalyticsBulk(c *gin.Context) {
+	var ids []int
+	if err := c.BindJSON(&ids); err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	if len(ids) == 0 || len(ids) > bulk.MaxBatchSize {
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{
+			Code:    apperr.CodeBadRequest,
+			Message: "batch size must be between 1 and 500",
+		})
+		return
+	}
+
+	results, err := h.srv.DeleteAnalyticsBulk(c.Request.Context(), ids, bulkBestEffort(c))
+	if err != nil {
+		respond.FromError(c, err)
+		return
+	}
+
+	report := bulkReportFrom(results, func(id int) string { return strconv.Itoa(id) })
+	respondBulkReport(c, report, http.StatusOK)
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/himmel520/uoffer/require/internal/bulk"
+	"github.com/himmel520/uoffer/require/internal/respond"
+)
+
+// bulkItemStatus is the outcome of a single item within a bulk request.
+type bulkItemStatus string
+
+const (
+	bulkItemOK     bulkItemStatus = "ok"
+	bulkItemFailed bulkItemStatus = "failed"
+)
+
+// bulkItemResult reports what happened to one item of a bulk request, so
+// partial failures are visible without aborting the whole batch.
+type bulkItemResult struct {
+	Index  int            `json:"index"`
+	Status bulkItemStatus `json:"status"`
+	ID     string         `json:"id,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// bulkReport is the response envelope payload for every bulk endpoint.
+type bulkReport struct {
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Items     []bulkItemResult `json:"items"`
+}
+
+func newBulkReport(items []bulkItemResult) bulkReport {
+	report := bulkReport{Items: items}
+	for _, item := range items {
+		if item.Status == bulkItemOK {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+
+	return report
+}
+
+// bulkReportFrom converts the per-item results returned by the service
+// layer into the HTTP-facing report, deriving each successful item's id
+// via idOf.
+func bulkReportFrom[T any](results []bulk.Result[T], idOf func(T) string) bulkReport {
+	items := make([]bulkItemResult, len(results))
+	for i, r := range results {
+		if r.Err != nil {
+			items[i] = bulkItemResult{Index: i, Status: bulkItemFailed, Error: r.Err.Error()}
+			continue
+		}
+		items[i] = bulkItemResult{Index: i, Status: bulkItemOK, ID: idOf(r.Value)}
+	}
+
+	return newBulkReport(items)
+}
+
+// bulkBestEffort reads the `?best_effort=` query flag that lets a client
+// opt into per-row isolation instead of an all-or-nothing transaction.
+func bulkBestEffort(c interface{ Query(string) string }) bool {
+	return c.Query("best_effort") == "true"
+}
+
+// bulkStatus picks the overall HTTP status for a bulk response: 207 when
+// the batch is a mix of successes and failures, 200/201 when it fully
+// succeeded, and 400 when every item failed.
+func bulkStatus(report bulkReport, successStatus int) int {
+	switch {
+	case report.Failed == 0:
+		return successStatus
+	case report.Succeeded == 0:
+		return http.StatusBadRequest
+	default:
+		return http.StatusMultiStatus
+	}
+}
+
+// respondBulkReport writes report with an envelope status that agrees
+// with the HTTP status bulkStatus picks: "ok" when everything succeeded,
+// "partial" for a 207 mix, and "error" when every item failed.
+func respondBulkReport(c *gin.Context, report bulkReport, successStatus int) {
+	status := bulkStatus(report, successStatus)
+
+	switch {
+	case report.Failed == 0:
+		respond.Envelope(c, status, respond.StatusOK, report)
+	case report.Succeeded == 0:
+		respond.Envelope(c, status, respond.StatusError, report)
+	default:
+		respond.Envelope(c, status, respond.StatusPartial, report)
+	}
+}
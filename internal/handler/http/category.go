@@ -1,80 +1,148 @@
 package http
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/himmel520/uoffer/require/internal/apperr"
+	"github.com/himmel520/uoffer/require/internal/pagination"
 	"github.com/himmel520/uoffer/require/internal/repository"
+	"github.com/himmel520/uoffer/require/internal/respond"
 	"github.com/himmel520/uoffer/require/models"
 )
 
+// categorySortColumns is the allow-list of columns clients may sort
+// categories by, keyed by the `sort` query value.
+var categorySortColumns = map[string]string{
+	"title":      "title",
+	"created_at": "created_at",
+}
+
 // @Summary Получить категории с публичными постами
-// @Description Возвращает список категорий с публичными постами
+// @Description Возвращает список категорий с публичными постами из кэша, обновляемого по расписанию
 // @Tags Categories
 // @Produce json
 // @Success 200 {object} map[string][]models.PostResponse
-// @Failure 404 {object} errorResponse "Post Not Found"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Success 304 "Not Modified"
+// @Failure 404 {object} respond.APIResponse[any] "Post Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /categories/public-posts [get]
 func (h *Handler) getCategoriesWithPublicPosts(c *gin.Context) {
-	response, err := h.srv.GetCategoriesWithPublicPosts(c.Request.Context())
-	if err != nil {
-		if errors.Is(err, repository.ErrPostNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
+	// A successful response is always served raw, never wrapped in
+	// respond.APIResponse: the warm path serves bytes shared across
+	// requests, which must stay stable for the ETag to mean anything and
+	// so can't carry a per-request request_id. The cold path matches that
+	// shape so clients see the same JSON regardless of cache state; only
+	// its error response uses the envelope.
+	body, etag, lastModified, ok := h.publicPostsCache.Snapshot()
+	if !ok {
+		response, err := h.srv.GetCategoriesWithPublicPosts(c.Request.Context())
+		if err != nil {
+			respond.FromError(c, err)
 			return
 		}
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	c.Header("ETag", etag)
+	c.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// @Summary Принудительно обновить кэш категорий с публичными постами
+// @Description Синхронно пересобирает кэш, не дожидаясь следующего тика расписания
+// @Tags Categories
+// @Produce json
+// @Success 204 "No Content"
+// @Router /admin/cache/refresh [get]
+func (h *Handler) refreshPublicPostsCache(c *gin.Context) {
+	h.publicPostsCache.Refresh(c.Request.Context())
+	c.Status(http.StatusNoContent)
 }
 
 // @Summary Получить категории с постами
-// @Description Возвращает список категорий с постами
+// @Description Возвращает постраничный список категорий с постами
 // @Tags Categories
 // @Produce json
-// @Success 200 {object} map[string][]models.PostResponse
-// @Failure 404 {object} errorResponse "Post Not Found"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Param page query int false "Номер страницы" default(1)
+// @Param per_page query int false "Размер страницы" default(20)
+// @Param sort query string false "Поле сортировки (title, created_at)"
+// @Param order query string false "Направление сортировки (asc, desc)"
+// @Success 200 {object} respond.APIResponse[pagination.Page[models.PostResponse]]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 404 {object} respond.APIResponse[any] "Post Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/categories/posts [get]
 func (h *Handler) getCategoriesWithPosts(c *gin.Context) {
-	response, err := h.srv.GetCategoriesWithPosts(c.Request.Context())
+	params, err := pagination.Bind(c)
 	if err != nil {
-		if errors.Is(err, repository.ErrPostNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
-			return
-		}
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
+		return
+	}
+
+	orderBy, err := pagination.NewOrderBy(params, categorySortColumns, "title")
+	if err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+	params.Sort = orderBy.Column
+	params.Order = orderBy.Direction
+
+	response, err := h.srv.GetCategoriesWithPosts(c.Request.Context(), params)
+	if err != nil {
+		respond.FromError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	respond.OK(c, http.StatusOK, response)
 }
 
 // @Summary Получить все категории
-// @Description Возвращает список всех категорий
+// @Description Возвращает постраничный список всех категорий
 // @Tags Categories
 // @Produce json
-// @Success 200 {object} []models.Category
-// @Failure 404 {object} errorResponse "Category Not Found"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Param page query int false "Номер страницы" default(1)
+// @Param per_page query int false "Размер страницы" default(20)
+// @Param sort query string false "Поле сортировки (title, created_at)"
+// @Param order query string false "Направление сортировки (asc, desc)"
+// @Param title_like query string false "Фильтр по вхождению в название"
+// @Success 200 {object} respond.APIResponse[pagination.Page[models.Category]]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 404 {object} respond.APIResponse[any] "Category Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/categories [get]
 func (h *Handler) getAllCategories(c *gin.Context) {
-	response, err := h.srv.GetAllCategories(c.Request.Context())
+	params, err := pagination.Bind(c)
 	if err != nil {
-		if errors.Is(err, repository.ErrCategoryNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
-			return
-		}
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
+		return
+	}
+
+	orderBy, err := pagination.NewOrderBy(params, categorySortColumns, "title")
+	if err != nil {
+		respond.BadRequest(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, response)
+	params.Sort = orderBy.Column
+	params.Order = orderBy.Direction
+
+	filter := repository.CategoryFilter{TitleLike: c.Query("title_like")}
+
+	response, err := h.srv.GetAllCategories(c.Request.Context(), params, filter)
+	if err != nil {
+		respond.FromError(c, err)
+		return
+	}
+
+	respond.OK(c, http.StatusOK, response)
 }
 
 // @Summary Добавить новую категорию
@@ -83,31 +151,25 @@ func (h *Handler) getAllCategories(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param category body models.Category true "Данные новой категории"
-// @Success 201 {object} models.Category
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 409 {object} errorResponse "Category Already Exists"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Success 201 {object} respond.APIResponse[models.Category]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 409 {object} respond.APIResponse[any] "Category Already Exists"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/categories [post]
 func (h *Handler) addCategory(c *gin.Context) {
 	category := &models.Category{}
 	if err := c.BindJSON(category); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
 		return
 	}
 
 	newCategory, err := h.srv.AddCategory(c.Request.Context(), category)
 	if err != nil {
-		if errors.Is(err, repository.ErrCategoryExists) {
-			c.AbortWithStatusJSON(http.StatusConflict, errorResponse{err.Error()})
-			return
-		}
-
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+		respond.FromError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, newCategory)
+	respond.OK(c, http.StatusCreated, newCategory)
 }
 
 // @Summary Обновить категорию
@@ -117,37 +179,31 @@ func (h *Handler) addCategory(c *gin.Context) {
 // @Produce json
 // @Param category path string true "Категория"
 // @Param title query string true "Новое название категории"
-// @Success 200 {object} models.Category
-// @Failure 400 {object} errorResponse "Bad Request"
-// @Failure 404 {object} errorResponse "Category Not Found"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Success 200 {object} respond.APIResponse[models.Category]
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 404 {object} respond.APIResponse[any] "Category Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/categories/{category} [put]
 func (h *Handler) updateCategory(c *gin.Context) {
 	var uri categoryURI
 	if err := c.BindUri(&uri); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
 		return
 	}
 
 	var query updateCategoryQuery
 	if err := c.BindQuery(&query); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
 		return
 	}
 
 	newCategory, err := h.srv.UpdateCategory(c.Request.Context(), uri.Category, query.Title)
 	if err != nil {
-		if errors.Is(err, repository.ErrCategoryNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
-			return
-		}
-
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+		respond.FromError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, newCategory)
+	respond.OK(c, http.StatusOK, newCategory)
 }
 
 // @Summary Удалить категорию
@@ -155,24 +211,18 @@ func (h *Handler) updateCategory(c *gin.Context) {
 // @Tags Categories
 // @Param category path string true "Категория"
 // @Success 204 "No Content"
-// @Failure 404 {object} errorResponse "Category Not Found"
-// @Failure 500 {object} errorResponse "Internal Server Error"
+// @Failure 404 {object} respond.APIResponse[any] "Category Not Found"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
 // @Router /admin/categories/{category} [delete]
 func (h *Handler) deleteCategory(c *gin.Context) {
 	var uri *categoryURI
 	if err := c.BindUri(&uri); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, errorResponse{err.Error()})
+		respond.BadRequest(c, err)
 		return
 	}
 
 	if err := h.srv.DeleteCategory(c.Request.Context(), uri.Category); err != nil {
-		if errors.Is(err, repository.ErrCategoryNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, errorResponse{err.Error()})
-			return
-		}
-
-		h.log.Error(err.Error())
-		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{err.Error()})
+		respond.FromError(c, err)
 		return
 	}
 
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/himmel520/uoffer/require/internal/apperr"
+	"github.com/himmel520/uoffer/require/internal/bulk"
+	"github.com/himmel520/uoffer/require/internal/respond"
+	"github.com/himmel520/uoffer/require/models"
+)
+
+func categoryID(category *models.Category) string {
+	return category.Title
+}
+
+// @Summary Массово добавить категории
+// @Description Добавляет несколько категорий одной транзакцией
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param best_effort query bool false "Не прерывать пакет при ошибке в отдельной строке"
+// @Param categories body []models.Category true "Список новых категорий"
+// @Success 200 {object} respond.APIResponse[bulkReport]
+// @Failure 207 {object} respond.APIResponse[bulkReport] "Multi-Status"
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /admin/categories/bulk [post]
+func (h *Handler) addCategoriesBulk(c *gin.Context) {
+	var categories []*models.Category
+	if err := c.BindJSON(&categories); err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	if len(categories) == 0 || len(categories) > bulk.MaxBatchSize {
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{
+			Code:    apperr.CodeBadRequest,
+			Message: "batch size must be between 1 and 500",
+		})
+		return
+	}
+
+	results, err := h.srv.AddCategoriesBulk(c.Request.Context(), categories, bulkBestEffort(c))
+	if err != nil {
+		respond.FromError(c, err)
+		return
+	}
+
+	report := bulkReportFrom(results, categoryID)
+	respondBulkReport(c, report, http.StatusOK)
+}
+
+// @Summary Массово обновить категории
+// @Description Обновляет несколько категорий одной транзакцией
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param best_effort query bool false "Не прерывать пакет при ошибке в отдельной строке"
+// @Param categories body []models.Category true "Список обновляемых категорий"
+// @Success 200 {object} respond.APIResponse[bulkReport]
+// @Failure 207 {object} respond.APIResponse[bulkReport] "Multi-Status"
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /admin/categories/bulk [put]
+func (h *Handler) updateCategoriesBulk(c *gin.Context) {
+	var categories []*models.Category
+	if err := c.BindJSON(&categories); err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	if len(categories) == 0 || len(categories) > bulk.MaxBatchSize {
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{
+			Code:    apperr.CodeBadRequest,
+			Message: "batch size must be between 1 and 500",
+		})
+		return
+	}
+
+	results, err := h.srv.UpdateCategoriesBulk(c.Request.Context(), categories, bulkBestEffort(c))
+	if err != nil {
+		respond.FromError(c, err)
+		return
+	}
+
+	report := bulkReportFrom(results, categoryID)
+	respondBulkReport(c, report, http.StatusOK)
+}
+
+// @Summary Массово удалить категории
+// @Description Удаляет несколько категорий одной транзакцией
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param best_effort query bool false "Не прерывать пакет при ошибке в отдельной строке"
+// @Param categories body []string true "Список названий категорий"
+// @Success 200 {object} respond.APIResponse[bulkReport]
+// @Failure 207 {object} respond.APIResponse[bulkReport] "Multi-Status"
+// @Failure 400 {object} respond.APIResponse[any] "Bad Request"
+// @Failure 500 {object} respond.APIResponse[any] "Internal Server Error"
+// @Router /admin/categories/bulk [delete]
+func (h *Handler) deleteCategoriesBulk(c *gin.Context) {
+	var categories []string
+	if err := c.BindJSON(&categories); err != nil {
+		respond.BadRequest(c, err)
+		return
+	}
+
+	if len(categories) == 0 || len(categories) > bulk.MaxBatchSize {
+		respond.Fail(c, http.StatusBadRequest, apperr.APIError{
+			Code:    apperr.CodeBadRequest,
+			Message: "batch size must be between 1 and 500",
+		})
+		return
+	}
+
+	results, err := h.srv.DeleteCategoriesBulk(c.Request.Context(), categories, bulkBestEffort(c))
+	if err != nil {
+		respond.FromError(c, err)
+		return
+	}
+
+	report := bulkReportFrom(results, func(title string) string { return title })
+	respondBulkReport(c, report, http.StatusOK)
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorLogger is the minimal logging surface middleware needs; it matches
+// the Error(string) method already used throughout the http handlers.
+type errorLogger interface {
+	Error(string)
+}
+
+// Logging logs every request that ends in a 5xx response, stamping the
+// line with the request id set by RequestID so failed requests can be
+// correlated with the envelope returned to the client.
+func Logging(log errorLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if status := c.Writer.Status(); status >= 500 {
+			log.Error(fmt.Sprintf("request_id=%s status=%d path=%s errors=%s",
+				RequestIDFromContext(c), status, c.Request.URL.Path, c.Errors.String()))
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Package middleware holds gin middleware shared across the http handlers.
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader is both the inbound header used to accept a caller's
+// request id and the outbound header used to echo it back.
+const RequestIDHeader = "X-Request-ID"
+
+const requestIDKey = "request_id"
+
+// RequestID stamps every request with an id (reusing the caller's
+// X-Request-ID header when present) so it can be attached to the response
+// envelope and to log lines for that request.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+
+		c.Set(requestIDKey, id)
+		c.Writer.Header().Set(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the id stamped on c by the RequestID
+// middleware, or an empty string when the middleware was not installed
+// (e.g. in tests).
+func RequestIDFromContext(c *gin.Context) string {
+	id, ok := c.Get(requestIDKey)
+	if !ok {
+		return ""
+	}
+
+	s, _ := id.(string)
+	return s
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,102 @@
+// Package pagination provides a reusable pagination layer shared by the
+// http, service and repository layers, so every list endpoint exposes the
+// same `?page=&per_page=&sort=&order=` contract and the same response
+// envelope.
+package pagination
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	DefaultPage    = 1
+	DefaultPerPage = 20
+	MinPerPage     = 1
+	MaxPerPage     = 100
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Params carries the paging and ordering options bound from a request query
+// string. Sort is validated by the caller against a per-resource allow-list
+// via NewOrderBy.
+type Params struct {
+	Page    int
+	PerPage int
+	Sort    string
+	Order   string
+}
+
+// Bind reads page/per_page/sort/order from the query string, clamping
+// PerPage to [MinPerPage, MaxPerPage] and defaulting missing values.
+func Bind(c *gin.Context) (Params, error) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", strconv.Itoa(DefaultPage)))
+	if err != nil || page < 1 {
+		return Params{}, fmt.Errorf("invalid page: %s", c.Query("page"))
+	}
+
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", strconv.Itoa(DefaultPerPage)))
+	if err != nil {
+		return Params{}, fmt.Errorf("invalid per_page: %s", c.Query("per_page"))
+	}
+	if perPage < MinPerPage {
+		perPage = MinPerPage
+	}
+	if perPage > MaxPerPage {
+		perPage = MaxPerPage
+	}
+
+	order := strings.ToLower(c.DefaultQuery("order", OrderAsc))
+	if order != OrderAsc && order != OrderDesc {
+		return Params{}, fmt.Errorf("invalid order: %s", order)
+	}
+
+	return Params{
+		Page:    page,
+		PerPage: perPage,
+		Sort:    c.Query("sort"),
+		Order:   order,
+	}, nil
+}
+
+// Offset returns the SQL OFFSET for the current page.
+func (p Params) Offset() int {
+	return (p.Page - 1) * p.PerPage
+}
+
+// Limit returns the SQL LIMIT for the current page.
+func (p Params) Limit() int {
+	return p.PerPage
+}
+
+// Page is the envelope returned by every paginated list endpoint.
+type Page[T any] struct {
+	Items      []T `json:"items"`
+	Page       int `json:"page"`
+	PerPage    int `json:"per_page"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
+
+// NewPage builds a Page from the fetched items, the total row count
+// (typically obtained from a COUNT(*) query run in the same transaction as
+// the SELECT) and the params that produced the page.
+func NewPage[T any](items []T, total int, p Params) Page[T] {
+	totalPages := 0
+	if p.PerPage > 0 {
+		totalPages = (total + p.PerPage - 1) / p.PerPage
+	}
+
+	return Page[T]{
+		Items:      items,
+		Page:       p.Page,
+		PerPage:    p.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
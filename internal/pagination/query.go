@@ -0,0 +1,37 @@
+package pagination
+
+import "fmt"
+
+// OrderBy is a validated `ORDER BY <column> <direction>` fragment built from
+// an allow-list of sortable columns for a given resource, so Params.Sort
+// never reaches the query builder unescaped.
+type OrderBy struct {
+	Column    string
+	Direction string
+}
+
+// NewOrderBy validates p.Sort against allowed (a map of query-param name to
+// the actual column name) and falls back to defaultSort when p.Sort is
+// empty. It returns an error when p.Sort is set but not in the allow-list.
+// Callers copy the result's Column/Direction back into Params.Sort/Order
+// before passing Params down to the repository, which builds the actual
+// `ORDER BY ... LIMIT ... OFFSET ...` fragment from those now-trusted
+// values plus Params.Limit()/Offset().
+func NewOrderBy(p Params, allowed map[string]string, defaultSort string) (OrderBy, error) {
+	sort := p.Sort
+	if sort == "" {
+		sort = defaultSort
+	}
+
+	column, ok := allowed[sort]
+	if !ok {
+		return OrderBy{}, fmt.Errorf("sort column %q is not allowed", sort)
+	}
+
+	direction := OrderAsc
+	if p.Order == OrderDesc {
+		direction = OrderDesc
+	}
+
+	return OrderBy{Column: column, Direction: direction}, nil
+}
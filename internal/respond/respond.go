@@ -0,0 +1,75 @@
+// Package respond centralizes how handlers write their JSON response, so
+// every endpoint returns the same {status, code, message, payload,
+// request_id} envelope instead of ad-hoc structs.
+package respond
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/himmel520/uoffer/require/internal/apperr"
+	"github.com/himmel520/uoffer/require/internal/middleware"
+)
+
+// APIResponse is the canonical envelope for both success and error
+// responses. Payload is omitted on failure, Code/Message are omitted on
+// success.
+type APIResponse[T any] struct {
+	Status    string `json:"status"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Payload   T      `json:"payload,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Envelope statuses. StatusPartial exists for responses like a bulk
+// report where some items succeeded and some failed, so the envelope
+// status agrees with a mixed-outcome HTTP status such as 207 Multi-Status.
+const (
+	StatusOK      = "ok"
+	StatusError   = "error"
+	StatusPartial = "partial"
+)
+
+// OK writes a successful envelope with the given HTTP status and payload.
+func OK[T any](c *gin.Context, status int, payload T) {
+	Envelope(c, status, StatusOK, payload)
+}
+
+// Envelope writes an envelope with an explicit envelope status, for
+// responses that are neither a plain success nor a plain failure (e.g. a
+// bulk report where some items failed).
+func Envelope[T any](c *gin.Context, httpStatus int, status string, payload T) {
+	c.JSON(httpStatus, APIResponse[T]{
+		Status:    status,
+		Payload:   payload,
+		RequestID: middleware.RequestIDFromContext(c),
+	})
+}
+
+// Fail writes a failed envelope for apiErr, aborting the request chain.
+func Fail(c *gin.Context, status int, apiErr apperr.APIError) {
+	c.AbortWithStatusJSON(status, APIResponse[any]{
+		Status:    StatusError,
+		Code:      apiErr.Code,
+		Message:   apiErr.Message,
+		RequestID: middleware.RequestIDFromContext(c),
+	})
+}
+
+// FromError maps err via apperr.Map and writes the resulting envelope. It
+// is the replacement for the errors.Is switch chains handlers used to
+// write by hand. err is also attached to the gin context so
+// middleware.Logging can record the real cause of a 500 even though the
+// client-facing message is a generic one.
+func FromError(c *gin.Context, err error) {
+	c.Error(err)
+
+	status, apiErr := apperr.Map(err)
+	Fail(c, status, apiErr)
+}
+
+// BadRequest is a shortcut for the common "binding/validation failed" case.
+func BadRequest(c *gin.Context, err error) {
+	Fail(c, http.StatusBadRequest, apperr.BadRequest(err))
+}